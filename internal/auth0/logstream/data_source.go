@@ -0,0 +1,121 @@
+package logstream
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewDataSource will return a new auth0_log_stream data source.
+func NewDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: readLogStreamForDataSource,
+		Description: "Use this data source to access information about an existing log stream, " +
+			"looked up by `id` or by `name`.",
+		Schema: dataSourceSchema(),
+	}
+}
+
+// resourceOnlySchemaFields are resource attributes that express user intent
+// rather than observed state (e.g. desired_status), so they have no meaning
+// on a read-only data source and must not be mirrored into it.
+var resourceOnlySchemaFields = map[string]bool{
+	"desired_status": true,
+}
+
+func dataSourceSchema() map[string]*schema.Schema {
+	dataSourceSchema := map[string]*schema.Schema{
+		"id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"name"},
+			Description:   "The ID of the log stream. Either `id` or `name` must be specified.",
+		},
+	}
+
+	for key, value := range NewResource().Schema {
+		if resourceOnlySchemaFields[key] {
+			continue
+		}
+		dataSourceSchema[key] = computedSchema(value)
+	}
+
+	dataSourceSchema["name"].Optional = true
+	dataSourceSchema["name"].Required = false
+	dataSourceSchema["name"].ConflictsWith = []string{"id"}
+	dataSourceSchema["name"].Description = "The name of the log stream. Either `id` or `name` must be specified."
+
+	return dataSourceSchema
+}
+
+func computedSchema(original *schema.Schema) *schema.Schema {
+	computed := &schema.Schema{
+		Type:        original.Type,
+		Computed:    true,
+		Description: original.Description,
+	}
+
+	if nested, ok := original.Elem.(*schema.Resource); ok {
+		nestedSchema := make(map[string]*schema.Schema, len(nested.Schema))
+		for key, value := range nested.Schema {
+			nestedSchema[key] = computedSchema(value)
+		}
+		computed.Elem = &schema.Resource{Schema: nestedSchema}
+	} else {
+		computed.Elem = original.Elem
+	}
+
+	return computed
+}
+
+func readLogStreamForDataSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+
+	var logStream *management.LogStream
+
+	if id, ok := d.GetOk("id"); ok {
+		fetchedLogStream, err := api.LogStream.Read(id.(string))
+		if err != nil {
+			if mErr, ok := err.(management.Error); ok && mErr.Status() == http.StatusNotFound {
+				return diag.Errorf("No log stream found with id %q", id.(string))
+			}
+			return diag.FromErr(err)
+		}
+		logStream = fetchedLogStream
+	} else if name, ok := d.GetOk("name"); ok {
+		logStreams, err := api.LogStream.List()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, candidate := range logStreams {
+			if candidate.GetName() == name.(string) {
+				logStream = candidate
+				break
+			}
+		}
+
+		if logStream == nil {
+			return diag.Errorf("No log stream found with name %q", name.(string))
+		}
+	} else {
+		return diag.Errorf("one of `id` or `name` must be specified")
+	}
+
+	d.SetId(logStream.GetID())
+
+	result := multierror.Append(
+		d.Set("name", logStream.GetName()),
+		d.Set("status", logStream.GetStatus()),
+		d.Set("type", logStream.GetType()),
+		d.Set("filters", logStream.Filters),
+		d.Set("sink", flattenLogStreamSink(d, logStream.Sink)),
+	)
+
+	return diag.FromErr(result.ErrorOrNil())
+}
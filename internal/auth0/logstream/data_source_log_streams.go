@@ -0,0 +1,101 @@
+package logstream
+
+import (
+	"context"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewLogStreamsDataSource will return a new auth0_log_streams data source.
+func NewLogStreamsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: readLogStreamsForDataSource,
+		Description: "Use this data source to list existing log streams, optionally filtered by `type` and `status`.",
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(validLogStreamTypes, true),
+				Description: "Filter the results to log streams of the given type. Options include: `" +
+					strings.Join(validLogStreamTypes, "`, `") + "`.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"active",
+					"paused",
+					"suspended",
+				}, false),
+				Description: "Filter the results to log streams with the given status.",
+			},
+			"log_streams": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of log streams matching the given filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the log stream.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the log stream.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the log stream.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the log stream.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readLogStreamsForDataSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+
+	logStreams, err := api.LogStream.List()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	typeFilter := d.Get("type").(string)
+	statusFilter := d.Get("status").(string)
+
+	flattenedLogStreams := make([]interface{}, 0, len(logStreams))
+	for _, logStream := range logStreams {
+		if typeFilter != "" && logStream.GetType() != typeFilter {
+			continue
+		}
+		if statusFilter != "" && logStream.GetStatus() != statusFilter {
+			continue
+		}
+
+		flattenedLogStreams = append(flattenedLogStreams, map[string]interface{}{
+			"id":     logStream.GetID(),
+			"name":   logStream.GetName(),
+			"type":   logStream.GetType(),
+			"status": logStream.GetStatus(),
+		})
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return diag.FromErr(d.Set("log_streams", flattenedLogStreams))
+}
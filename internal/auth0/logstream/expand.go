@@ -0,0 +1,122 @@
+package logstream
+
+import (
+	"github.com/auth0/go-auth0"
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func expandLogStream(d *schema.ResourceData) *management.LogStream {
+	logStream := &management.LogStream{
+		Sink: expandLogStreamSink(d),
+	}
+
+	// The Management API does not allow changing the name or type of a log
+	// stream once it has been created, so these are only sent on create.
+	if d.IsNewResource() {
+		logStream.Name = auth0.String(d.Get("name").(string))
+		logStream.Type = auth0.String(d.Get("type").(string))
+
+		if filters := d.Get("filters").([]interface{}); len(filters) > 0 {
+			logStream.Filters = expandLogStreamFilters(filters)
+		}
+	}
+
+	return logStream
+}
+
+func expandLogStreamFilters(filters []interface{}) *[]map[string]string {
+	logStreamFilters := make([]map[string]string, 0, len(filters))
+
+	for _, filter := range filters {
+		rawFilter := filter.(map[string]interface{})
+
+		logStreamFilter := make(map[string]string, len(rawFilter))
+		for key, value := range rawFilter {
+			logStreamFilter[key] = value.(string)
+		}
+
+		logStreamFilters = append(logStreamFilters, logStreamFilter)
+	}
+
+	return &logStreamFilters
+}
+
+func expandLogStreamSink(d *schema.ResourceData) interface{} {
+	sinkType := d.Get("type").(string)
+
+	rawSink := d.Get("sink").([]interface{})
+	if len(rawSink) == 0 {
+		return nil
+	}
+	sink := rawSink[0].(map[string]interface{})
+
+	switch sinkType {
+	case "eventbridge":
+		return &management.LogStreamSinkAmazonEventBridge{
+			AccountID: auth0.String(sink["aws_account_id"].(string)),
+			Region:    auth0.String(sink["aws_region"].(string)),
+		}
+	case "eventgrid":
+		return &management.LogStreamSinkAzureEventGrid{
+			SubscriptionID: auth0.String(sink["azure_subscription_id"].(string)),
+			ResourceGroup:  auth0.String(sink["azure_resource_group"].(string)),
+			Region:         auth0.String(sink["azure_region"].(string)),
+		}
+	case "http":
+		return &management.LogStreamSinkHTTP{
+			Endpoint:      auth0.String(sink["http_endpoint"].(string)),
+			Authorization: auth0.String(sink["http_authorization"].(string)),
+			ContentType:   auth0.String(sink["http_content_type"].(string)),
+			ContentFormat: auth0.String(sink["http_content_format"].(string)),
+			CustomHeaders: expandLogStreamSinkHTTPCustomHeaders(sink["http_custom_headers"]),
+		}
+	case "datadog":
+		return &management.LogStreamSinkDatadog{
+			Region: auth0.String(sink["datadog_region"].(string)),
+			APIKey: auth0.String(sink["datadog_api_key"].(string)),
+		}
+	case "splunk":
+		return &management.LogStreamSinkSplunk{
+			Domain: auth0.String(sink["splunk_domain"].(string)),
+			Token:  auth0.String(sink["splunk_token"].(string)),
+			Port:   auth0.String(sink["splunk_port"].(string)),
+			Secure: auth0.Bool(sink["splunk_secure"].(bool)),
+		}
+	case "sumo":
+		return &management.LogStreamSinkSumo{
+			SourceAddress: auth0.String(sink["sumo_source_address"].(string)),
+		}
+	case "mixpanel":
+		return &management.LogStreamSinkMixpanel{
+			Region:                 auth0.String(sink["mixpanel_region"].(string)),
+			ProjectID:              auth0.String(sink["mixpanel_project_id"].(string)),
+			ServiceAccountUsername: auth0.String(sink["mixpanel_service_account_username"].(string)),
+			ServiceAccountPassword: auth0.String(sink["mixpanel_service_account_password"].(string)),
+		}
+	case "segment":
+		return &management.LogStreamSinkSegment{
+			WriteKey: auth0.String(sink["segment_write_key"].(string)),
+		}
+	}
+
+	return nil
+}
+
+func expandLogStreamSinkHTTPCustomHeaders(raw interface{}) *[]map[string]string {
+	rawHeaders, ok := raw.([]interface{})
+	if !ok || len(rawHeaders) == 0 {
+		return nil
+	}
+
+	headers := make([]map[string]string, 0, len(rawHeaders))
+	for _, rawHeader := range rawHeaders {
+		header := make(map[string]string)
+		for key, value := range rawHeader.(map[string]interface{}) {
+			header[key] = value.(string)
+		}
+		headers = append(headers, header)
+	}
+
+	return &headers
+}
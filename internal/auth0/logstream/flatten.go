@@ -0,0 +1,93 @@
+package logstream
+
+import (
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func flattenLogStreamSink(d *schema.ResourceData, sink interface{}) []interface{} {
+	switch logStreamSink := sink.(type) {
+	case *management.LogStreamSinkAmazonEventBridge:
+		return []interface{}{
+			map[string]interface{}{
+				"aws_account_id":           logStreamSink.GetAccountID(),
+				"aws_region":               logStreamSink.GetRegion(),
+				"aws_partner_event_source": logStreamSink.GetPartnerEventSource(),
+			},
+		}
+	case *management.LogStreamSinkAzureEventGrid:
+		return []interface{}{
+			map[string]interface{}{
+				"azure_subscription_id": logStreamSink.GetSubscriptionID(),
+				"azure_resource_group":  logStreamSink.GetResourceGroup(),
+				"azure_region":          logStreamSink.GetRegion(),
+				"azure_partner_topic":   logStreamSink.GetPartnerTopic(),
+			},
+		}
+	case *management.LogStreamSinkHTTP:
+		return []interface{}{
+			map[string]interface{}{
+				"http_endpoint":       logStreamSink.GetEndpoint(),
+				"http_authorization":  d.Get("sink.0.http_authorization"),
+				"http_content_type":   logStreamSink.GetContentType(),
+				"http_content_format": logStreamSink.GetContentFormat(),
+				"http_custom_headers": flattenLogStreamSinkHTTPCustomHeaders(logStreamSink.CustomHeaders),
+			},
+		}
+	case *management.LogStreamSinkDatadog:
+		return []interface{}{
+			map[string]interface{}{
+				"datadog_region":  logStreamSink.GetRegion(),
+				"datadog_api_key": d.Get("sink.0.datadog_api_key"),
+			},
+		}
+	case *management.LogStreamSinkSplunk:
+		return []interface{}{
+			map[string]interface{}{
+				"splunk_domain": logStreamSink.GetDomain(),
+				"splunk_token":  d.Get("sink.0.splunk_token"),
+				"splunk_port":   logStreamSink.GetPort(),
+				"splunk_secure": logStreamSink.GetSecure(),
+			},
+		}
+	case *management.LogStreamSinkSumo:
+		return []interface{}{
+			map[string]interface{}{
+				"sumo_source_address": logStreamSink.GetSourceAddress(),
+			},
+		}
+	case *management.LogStreamSinkMixpanel:
+		return []interface{}{
+			map[string]interface{}{
+				"mixpanel_region":                   logStreamSink.GetRegion(),
+				"mixpanel_project_id":               logStreamSink.GetProjectID(),
+				"mixpanel_service_account_username": logStreamSink.GetServiceAccountUsername(),
+				"mixpanel_service_account_password": d.Get("sink.0.mixpanel_service_account_password"),
+			},
+		}
+	case *management.LogStreamSinkSegment:
+		return []interface{}{
+			map[string]interface{}{
+				"segment_write_key": d.Get("sink.0.segment_write_key"),
+			},
+		}
+	}
+
+	return nil
+}
+
+func flattenLogStreamSinkHTTPCustomHeaders(headers *[]map[string]string) []interface{} {
+	if headers == nil {
+		return nil
+	}
+
+	flattenedHeaders := make([]interface{}, 0, len(*headers))
+	for _, header := range *headers {
+		flattenedHeaders = append(flattenedHeaders, map[string]interface{}{
+			"header": header["header"],
+			"value":  header["value"],
+		})
+	}
+
+	return flattenedHeaders
+}
@@ -0,0 +1,142 @@
+package logstream
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceSchemaV0 reconstructs the full schema as it existed prior to
+// SchemaVersion 1: sink.http_custom_headers was a list of arbitrary string
+// maps rather than a list of `{header, value}` blocks, and filters was a
+// list of arbitrary string maps rather than a list of typed `{type, name}`
+// blocks (that change didn't land until SchemaVersion 2, so it must also be
+// reverted here). Every other attribute is taken from the current schema,
+// including desired_status, which older state does not have and which
+// therefore decodes as null. It's only used to compute the implied type for
+// the state upgrader below, which decodes real baseline state against it.
+//
+// It builds its schema map from resourceSchema(), NOT from NewResource():
+// NewResource's StateUpgraders are built from this function's result, so
+// going through NewResource here would recurse into it forever.
+func resourceSchemaV0() *schema.Resource {
+	schemaV0 := resourceSchema()
+
+	sinkResource := schemaV0["sink"].Elem.(*schema.Resource)
+	sinkResource.Schema["http_custom_headers"] = &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeMap,
+			Elem: &schema.Schema{Type: schema.TypeString},
+		},
+		Optional:    true,
+		Description: "Additional HTTP headers to be included as part of the HTTP request.",
+	}
+
+	schemaV0["filters"] = oldFiltersSchema()
+
+	return &schema.Resource{Schema: schemaV0}
+}
+
+// oldFiltersSchema is the filters schema as it existed prior to
+// SchemaVersion 2, shared by resourceSchemaV0 and resourceSchemaV1 since
+// both predate the typed `{type, name}` block.
+func oldFiltersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		Optional: true,
+		Description: "Only logs events matching these filters will be delivered by the stream." +
+			" If omitted or empty, all events will be delivered.",
+	}
+}
+
+// upgradeLogStreamStateV0 migrates state from the old sink.http_custom_headers
+// representation (a list of maps, each mapping arbitrary header names to
+// their values) to the new representation (a list of `{header, value}`
+// blocks), so that existing state files keep working after the schema change.
+func upgradeLogStreamStateV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	sinkList, ok := rawState["sink"].([]interface{})
+	if !ok || len(sinkList) == 0 {
+		return rawState, nil
+	}
+
+	sink, ok := sinkList[0].(map[string]interface{})
+	if !ok {
+		return rawState, nil
+	}
+
+	oldHeaders, ok := sink["http_custom_headers"].([]interface{})
+	if !ok {
+		return rawState, nil
+	}
+
+	newHeaders := make([]interface{}, 0, len(oldHeaders))
+	for _, rawHeaderMap := range oldHeaders {
+		headerMap, ok := rawHeaderMap.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for header, value := range headerMap {
+			newHeaders = append(newHeaders, map[string]interface{}{
+				"header": header,
+				"value":  value,
+			})
+		}
+	}
+
+	sink["http_custom_headers"] = newHeaders
+	sinkList[0] = sink
+	rawState["sink"] = sinkList
+
+	return rawState, nil
+}
+
+// resourceSchemaV1 reconstructs the filters schema as it existed prior to
+// SchemaVersion 2, when it was a list of arbitrary string maps rather than a
+// list of typed `{type, name}` blocks. It's only used to compute the implied
+// type for the state upgrader below.
+//
+// Like resourceSchemaV0, it builds from resourceSchema() rather than
+// NewResource() to avoid recursing back through NewResource's StateUpgraders.
+func resourceSchemaV1() *schema.Resource {
+	schemaV1 := resourceSchema()
+
+	schemaV1["filters"] = oldFiltersSchema()
+
+	return &schema.Resource{Schema: schemaV1}
+}
+
+// upgradeLogStreamStateV1 migrates state from the old filters representation
+// (a list of arbitrary string maps) to the new representation (a list of
+// typed `{type, name}` blocks), dropping any keys that aren't part of the new
+// schema, so that existing state files keep working after the schema change.
+func upgradeLogStreamStateV1(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	oldFilters, ok := rawState["filters"].([]interface{})
+	if !ok {
+		return rawState, nil
+	}
+
+	newFilters := make([]interface{}, 0, len(oldFilters))
+	for _, rawFilter := range oldFilters {
+		filter, ok := rawFilter.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		newFilters = append(newFilters, map[string]interface{}{
+			"type": filter["type"],
+			"name": filter["name"],
+		})
+	}
+
+	rawState["filters"] = newFilters
+
+	return rawState, nil
+}
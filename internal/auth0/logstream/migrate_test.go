@@ -0,0 +1,142 @@
+package logstream
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUpgradeLogStreamStateV0(t *testing.T) {
+	t.Parallel()
+
+	for name, testCase := range map[string]struct {
+		givenState     map[string]interface{}
+		expectedHeader []interface{}
+	}{
+		"no sink": {
+			givenState:     map[string]interface{}{},
+			expectedHeader: nil,
+		},
+		"no custom headers": {
+			givenState: map[string]interface{}{
+				"sink": []interface{}{
+					map[string]interface{}{"http_endpoint": "https://example.com"},
+				},
+			},
+			expectedHeader: nil,
+		},
+		"single header map migrates to header/value blocks": {
+			givenState: map[string]interface{}{
+				"sink": []interface{}{
+					map[string]interface{}{
+						"http_custom_headers": []interface{}{
+							map[string]interface{}{"X-Custom-Header": "foo"},
+						},
+					},
+				},
+			},
+			expectedHeader: []interface{}{
+				map[string]interface{}{"header": "X-Custom-Header", "value": "foo"},
+			},
+		},
+	} {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotState, err := upgradeLogStreamStateV0(context.Background(), testCase.givenState, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			sinkList, _ := gotState["sink"].([]interface{})
+			var gotHeaders []interface{}
+			if len(sinkList) > 0 {
+				sink, _ := sinkList[0].(map[string]interface{})
+				gotHeaders, _ = sink["http_custom_headers"].([]interface{})
+			}
+
+			if !reflect.DeepEqual(gotHeaders, testCase.expectedHeader) {
+				t.Errorf("got headers %#v, expected %#v", gotHeaders, testCase.expectedHeader)
+			}
+		})
+	}
+}
+
+func TestUpgradeLogStreamStateV1(t *testing.T) {
+	t.Parallel()
+
+	for name, testCase := range map[string]struct {
+		givenState      map[string]interface{}
+		expectedFilters []interface{}
+	}{
+		"no filters": {
+			givenState:      map[string]interface{}{},
+			expectedFilters: nil,
+		},
+		"known keys are preserved": {
+			givenState: map[string]interface{}{
+				"filters": []interface{}{
+					map[string]interface{}{"type": "category", "name": "auth.login.fail"},
+				},
+			},
+			expectedFilters: []interface{}{
+				map[string]interface{}{"type": "category", "name": "auth.login.fail"},
+			},
+		},
+		"misspelled/extra keys are dropped, not preserved": {
+			givenState: map[string]interface{}{
+				"filters": []interface{}{
+					map[string]interface{}{"typo": "category", "name": "auth.login.fail"},
+				},
+			},
+			expectedFilters: []interface{}{
+				map[string]interface{}{"type": nil, "name": "auth.login.fail"},
+			},
+		},
+	} {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotState, err := upgradeLogStreamStateV1(context.Background(), testCase.givenState, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			gotFilters, _ := gotState["filters"].([]interface{})
+			if !reflect.DeepEqual(gotFilters, testCase.expectedFilters) {
+				t.Errorf("got filters %#v, expected %#v", gotFilters, testCase.expectedFilters)
+			}
+		})
+	}
+}
+
+// TestResourceSchemaV0FiltersMatchesPreUpgradeShape guards against the bug
+// where resourceSchemaV0 left `filters` as whatever NewResource() currently
+// returns (the typed {type, name} block), instead of the untyped
+// TypeMap-of-strings shape that actually existed at SchemaVersion 0. If
+// filters regresses back to the typed block here, the V0 StateUpgrader would
+// fail to decode real baseline state containing arbitrary/misspelled keys.
+func TestResourceSchemaV0FiltersMatchesPreUpgradeShape(t *testing.T) {
+	t.Parallel()
+
+	filtersSchema := resourceSchemaV0().Schema["filters"]
+
+	if filtersSchema.Type != schema.TypeList {
+		t.Fatalf("expected filters to be a TypeList, got %v", filtersSchema.Type)
+	}
+
+	elemSchema, ok := filtersSchema.Elem.(*schema.Schema)
+	if !ok {
+		t.Fatalf("expected filters.Elem to be a plain *schema.Schema (map), got %T", filtersSchema.Elem)
+	}
+
+	if elemSchema.Type != schema.TypeMap {
+		t.Fatalf("expected filters.Elem to be a TypeMap, got %v", elemSchema.Type)
+	}
+}
@@ -2,12 +2,15 @@ package logstream
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/auth0/go-auth0/management"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -23,6 +26,76 @@ var validLogStreamTypes = []string{
 	"segment",
 }
 
+// validLogStreamFilterTypes are the filter "type"s Auth0 currently supports.
+// `category` is the only one the Management API accepts today, but it's kept
+// as a slice (rather than a single constant) to mirror validLogStreamTypes
+// and leave room for the API to grow.
+var validLogStreamFilterTypes = []string{
+	"category",
+}
+
+// validLogStreamFilterNames are the log event categories Auth0 supports
+// filtering log streams on, as documented by the LogStreamsManager filter
+// reference.
+var validLogStreamFilterNames = []string{
+	"auth.ancillary.fail",
+	"auth.ancillary.success",
+	"auth.login.fail",
+	"auth.login.success",
+	"auth.logout.fail",
+	"auth.logout.success",
+	"auth.signup.fail",
+	"auth.signup.success",
+	"auth.silent_auth.fail",
+	"auth.silent_auth.success",
+	"auth.token_exchange.fail",
+	"auth.token_exchange.success",
+	"management.fail",
+	"management.success",
+	"system.notification",
+	"user.fail",
+	"user.notification",
+	"user.success",
+	"other",
+}
+
+// forbiddenLogStreamHTTPHeaders are headers Auth0 controls itself, either
+// through dedicated sink attributes (http_content_type, http_authorization)
+// or because the service strips them before delivery.
+var forbiddenLogStreamHTTPHeaders = []string{
+	"content-type",
+	"content-length",
+	"authorization",
+	"host",
+	"connection",
+	"keep-alive",
+	"proxy-authenticate",
+	"proxy-authorization",
+	"te",
+	"trailer",
+	"transfer-encoding",
+	"upgrade",
+}
+
+func validateLogStreamHTTPHeader(i interface{}, k string) ([]string, []error) {
+	header, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	loweredHeader := strings.ToLower(header)
+	for _, forbidden := range forbiddenLogStreamHTTPHeaders {
+		if loweredHeader == forbidden {
+			return nil, []error{fmt.Errorf(
+				"%q is a reserved header and cannot be set through %q; "+
+					"use the dedicated sink attributes instead", header, k,
+			)}
+		}
+	}
+
+	return nil, nil
+}
+
 // NewResource will return a new auth0_log_stream resource.
 func NewResource() *schema.Resource {
 	return &schema.Resource{
@@ -33,227 +106,281 @@ func NewResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Description: "With this resource, you can manage your Auth0 log streams.",
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Name of the log stream.",
-			},
-			"type": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice(validLogStreamTypes, true),
-				ForceNew:     true,
-				Description: "Type of the log stream, which indicates the sink provider. " +
-					"Options include: `" + strings.Join(validLogStreamTypes, "`, `") + "`.",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+		SchemaVersion: 2,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceSchemaV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeLogStreamStateV0,
 			},
-			"status": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"active",
-					"paused",
-					"suspended",
-				}, false),
-				Description: "The current status of the log stream. Options are \"active\", \"paused\", \"suspended\".",
+			{
+				Version: 1,
+				Type:    resourceSchemaV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeLogStreamStateV1,
 			},
-			"filters": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Description: "Only logs events matching these filters will be delivered by the stream." +
-					" If omitted or empty, all events will be delivered.",
-				Elem: &schema.Schema{
-					Type: schema.TypeMap,
-					Elem: &schema.Schema{
-						Type: schema.TypeString,
+		},
+		Description: "With this resource, you can manage your Auth0 log streams.",
+		Schema:      resourceSchema(),
+	}
+}
+
+// resourceSchema returns the auth0_log_stream schema map. It's factored out
+// of NewResource so the state-upgrader helpers in migrate.go can reconstruct
+// older shapes of this schema without calling back into NewResource itself,
+// which builds StateUpgraders from those same helpers and would otherwise
+// recurse forever.
+func resourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the log stream.",
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(validLogStreamTypes, true),
+			ForceNew:     true,
+			Description: "Type of the log stream, which indicates the sink provider. " +
+				"Options include: `" + strings.Join(validLogStreamTypes, "`, `") + "`.",
+		},
+		"desired_status": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"active",
+				"paused",
+			}, false),
+			Description: "The status you want the log stream to have. Options are \"active\", \"paused\". " +
+				"Auth0 can independently move a log stream to \"suspended\" (e.g. after repeated sink delivery " +
+				"failures); use the computed `status` attribute to observe that.",
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "The actual status of the log stream as reported by Auth0. " +
+				"Options are \"active\", \"paused\", \"suspended\".",
+		},
+		"filters": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Description: "Only logs events matching these filters will be delivered by the stream." +
+				" If omitted or empty, all events will be delivered.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(validLogStreamFilterTypes, false),
+						Description: "Type of the filter. Options include: `" +
+							strings.Join(validLogStreamFilterTypes, "`, `") + "`.",
+					},
+					"name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(validLogStreamFilterNames, false),
+						Description: "Name of the log event category to filter on. Options include: `" +
+							strings.Join(validLogStreamFilterNames, "`, `") + "`.",
 					},
 				},
 			},
-			"sink": {
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Required:    true,
-				Description: "The sink configuration for the log stream.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"aws_account_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							RequiredWith: []string{"sink.0.aws_region"},
-							Description:  "The AWS Account ID.",
-						},
-						"aws_region": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							RequiredWith: []string{"sink.0.aws_account_id"},
-							Description:  "The AWS Region, e.g. \"us-east-2\").",
-						},
-						"aws_partner_event_source": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Optional: true,
-							Description: "Name of the Partner Event Source to be used with AWS. " +
-								"Generally generated by Auth0 and passed to AWS, so this should " +
-								"be an output attribute.",
-						},
-						"azure_subscription_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							RequiredWith: []string{"sink.0.azure_resource_group", "sink.0.azure_region"},
-							Description:  "The unique alphanumeric string that identifies your Azure subscription.",
-						},
-						"azure_resource_group": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							RequiredWith: []string{"sink.0.azure_subscription_id", "sink.0.azure_region"},
-							Description: "The Azure EventGrid resource group which allows you to manage all " +
-								"Azure assets within one subscription.",
-						},
-						"azure_region": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							RequiredWith: []string{"sink.0.azure_subscription_id", "sink.0.azure_resource_group"},
-							Description:  "The Azure region code, e.g. \"ne\")",
-						},
-						"azure_partner_topic": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Optional: true,
-							Description: "Name of the Partner Topic to be used with Azure. " +
-								"Generally should not be specified.",
-						},
-						"http_content_format": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							RequiredWith: []string{"sink.0.http_endpoint", "sink.0.http_authorization", "sink.0.http_content_type"},
-							Description: "The format of data sent over HTTP. Options are " +
-								"\"JSONLINES\", \"JSONARRAY\" or \"JSONOBJECT\"",
-							ValidateFunc: validation.StringInSlice([]string{
-								"JSONLINES",
-								"JSONARRAY",
-								"JSONOBJECT",
-							}, false),
-						},
-						"http_content_type": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Description: "The \"Content-Type\" header to send over HTTP. " +
-								"Common value is \"application/json\".",
-							RequiredWith: []string{"sink.0.http_endpoint", "sink.0.http_authorization", "sink.0.http_content_format"},
-						},
-						"http_endpoint": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Description:  "The HTTP endpoint to send streaming logs.",
-							RequiredWith: []string{"sink.0.http_content_format", "sink.0.http_authorization", "sink.0.http_content_type"},
-						},
-						"http_authorization": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Sensitive:    true,
-							Description:  "Sent in the HTTP \"Authorization\" header with each request.",
-							RequiredWith: []string{"sink.0.http_content_format", "sink.0.http_endpoint", "sink.0.http_content_type"},
-						},
-						"http_custom_headers": {
-							Type: schema.TypeList,
-							Elem: &schema.Schema{
-								Type: schema.TypeMap,
-								Elem: &schema.Schema{Type: schema.TypeString},
+		},
+		"sink": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Required:    true,
+			Description: "The sink configuration for the log stream.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_account_id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						RequiredWith: []string{"sink.0.aws_region"},
+						Description:  "The AWS Account ID.",
+					},
+					"aws_region": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						RequiredWith: []string{"sink.0.aws_account_id"},
+						Description:  "The AWS Region, e.g. \"us-east-2\").",
+					},
+					"aws_partner_event_source": {
+						Type:     schema.TypeString,
+						Computed: true,
+						Optional: true,
+						Description: "Name of the Partner Event Source to be used with AWS. " +
+							"Generally generated by Auth0 and passed to AWS, so this should " +
+							"be an output attribute.",
+					},
+					"azure_subscription_id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						RequiredWith: []string{"sink.0.azure_resource_group", "sink.0.azure_region"},
+						Description:  "The unique alphanumeric string that identifies your Azure subscription.",
+					},
+					"azure_resource_group": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						RequiredWith: []string{"sink.0.azure_subscription_id", "sink.0.azure_region"},
+						Description: "The Azure EventGrid resource group which allows you to manage all " +
+							"Azure assets within one subscription.",
+					},
+					"azure_region": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						RequiredWith: []string{"sink.0.azure_subscription_id", "sink.0.azure_resource_group"},
+						Description:  "The Azure region code, e.g. \"ne\")",
+					},
+					"azure_partner_topic": {
+						Type:     schema.TypeString,
+						Computed: true,
+						Optional: true,
+						Description: "Name of the Partner Topic to be used with Azure. " +
+							"Generally should not be specified.",
+					},
+					"http_content_format": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						RequiredWith: []string{"sink.0.http_endpoint", "sink.0.http_authorization", "sink.0.http_content_type"},
+						Description: "The format of data sent over HTTP. Options are " +
+							"\"JSONLINES\", \"JSONARRAY\" or \"JSONOBJECT\"",
+						ValidateFunc: validation.StringInSlice([]string{
+							"JSONLINES",
+							"JSONARRAY",
+							"JSONOBJECT",
+						}, false),
+					},
+					"http_content_type": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Description: "The \"Content-Type\" header to send over HTTP. " +
+							"Common value is \"application/json\".",
+						RequiredWith: []string{"sink.0.http_endpoint", "sink.0.http_authorization", "sink.0.http_content_format"},
+					},
+					"http_endpoint": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Description:  "The HTTP endpoint to send streaming logs.",
+						RequiredWith: []string{"sink.0.http_content_format", "sink.0.http_authorization", "sink.0.http_content_type"},
+					},
+					"http_authorization": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						Description:  "Sent in the HTTP \"Authorization\" header with each request.",
+						RequiredWith: []string{"sink.0.http_content_format", "sink.0.http_endpoint", "sink.0.http_content_type"},
+					},
+					"http_custom_headers": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Additional HTTP headers to be included as part of the HTTP request.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"header": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validateLogStreamHTTPHeader,
+									Description:  "Name of the additional HTTP header.",
+								},
+								"value": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "Value of the additional HTTP header.",
+								},
 							},
-							Optional:    true,
-							Default:     nil,
-							Description: "Additional HTTP headers to be included as part of the HTTP request.",
-						},
-						"datadog_region": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							RequiredWith: []string{"sink.0.datadog_api_key"},
-							ValidateFunc: validation.StringInSlice(
-								[]string{"us", "eu", "us3", "us5"},
-								false,
-							),
-							Description: "The Datadog region. Options are [\"us\", \"eu\", \"us3\", \"us5\"].",
-						},
-						"datadog_api_key": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Sensitive:    true,
-							RequiredWith: []string{"sink.0.datadog_region"},
-							Description:  "The Datadog API key.",
-						},
-						"splunk_domain": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							RequiredWith: []string{"sink.0.splunk_token", "sink.0.splunk_port", "sink.0.splunk_secure"},
-							Description:  "The Splunk domain name.",
-						},
-						"splunk_token": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Sensitive:    true,
-							RequiredWith: []string{"sink.0.splunk_domain", "sink.0.splunk_port", "sink.0.splunk_secure"},
-							Description:  "The Splunk access token.",
-						},
-						"splunk_port": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							RequiredWith: []string{"sink.0.splunk_domain", "sink.0.splunk_token", "sink.0.splunk_secure"},
-							Description:  "The Splunk port.",
-						},
-						"splunk_secure": {
-							Type:         schema.TypeBool,
-							Optional:     true,
-							Default:      nil,
-							RequiredWith: []string{"sink.0.splunk_domain", "sink.0.splunk_port", "sink.0.splunk_token"},
-							Description:  "This toggle should be turned off when using self-signed certificates.",
-						},
-						"sumo_source_address": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  nil,
-							Description: "Generated URL for your defined HTTP source in " +
-								"Sumo Logic for collecting streaming data from Auth0.",
-						},
-						"mixpanel_region": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							RequiredWith: []string{"sink.0.mixpanel_service_account_password", "sink.0.mixpanel_project_id", "sink.0.mixpanel_service_account_username"},
-							Description: "The Mixpanel region. Options are [\"us\", \"eu\"]. " +
-								"EU is required for customers with EU data residency requirements.",
-						},
-						"mixpanel_project_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							RequiredWith: []string{"sink.0.mixpanel_region", "sink.0.mixpanel_service_account_username", "sink.0.mixpanel_service_account_password"},
-							Description:  "The Mixpanel project ID, found on the Project Settings page.",
-						},
-						"mixpanel_service_account_username": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							RequiredWith: []string{"sink.0.mixpanel_region", "sink.0.mixpanel_project_id", "sink.0.mixpanel_service_account_password"},
-							Description:  "The Mixpanel Service Account username. Services Accounts can be created in the Project Settings page.",
-						},
-						"mixpanel_service_account_password": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Sensitive:    true,
-							RequiredWith: []string{"sink.0.mixpanel_region", "sink.0.mixpanel_project_id", "sink.0.mixpanel_service_account_username"},
-							Description:  "The Mixpanel Service Account password.",
-						},
-						"segment_write_key": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Sensitive:   true,
-							Description: "The [Segment Write Key](https://segment.com/docs/connections/find-writekey/).",
 						},
 					},
+					"datadog_region": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						RequiredWith: []string{"sink.0.datadog_api_key"},
+						ValidateFunc: validation.StringInSlice(
+							[]string{"us", "eu", "us3", "us5"},
+							false,
+						),
+						Description: "The Datadog region. Options are [\"us\", \"eu\", \"us3\", \"us5\"].",
+					},
+					"datadog_api_key": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						RequiredWith: []string{"sink.0.datadog_region"},
+						Description:  "The Datadog API key.",
+					},
+					"splunk_domain": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						RequiredWith: []string{"sink.0.splunk_token", "sink.0.splunk_port", "sink.0.splunk_secure"},
+						Description:  "The Splunk domain name.",
+					},
+					"splunk_token": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						RequiredWith: []string{"sink.0.splunk_domain", "sink.0.splunk_port", "sink.0.splunk_secure"},
+						Description:  "The Splunk access token.",
+					},
+					"splunk_port": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						RequiredWith: []string{"sink.0.splunk_domain", "sink.0.splunk_token", "sink.0.splunk_secure"},
+						Description:  "The Splunk port.",
+					},
+					"splunk_secure": {
+						Type:         schema.TypeBool,
+						Optional:     true,
+						Default:      nil,
+						RequiredWith: []string{"sink.0.splunk_domain", "sink.0.splunk_port", "sink.0.splunk_token"},
+						Description:  "This toggle should be turned off when using self-signed certificates.",
+					},
+					"sumo_source_address": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  nil,
+						Description: "Generated URL for your defined HTTP source in " +
+							"Sumo Logic for collecting streaming data from Auth0.",
+					},
+					"mixpanel_region": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						RequiredWith: []string{"sink.0.mixpanel_service_account_password", "sink.0.mixpanel_project_id", "sink.0.mixpanel_service_account_username"},
+						Description: "The Mixpanel region. Options are [\"us\", \"eu\"]. " +
+							"EU is required for customers with EU data residency requirements.",
+					},
+					"mixpanel_project_id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						RequiredWith: []string{"sink.0.mixpanel_region", "sink.0.mixpanel_service_account_username", "sink.0.mixpanel_service_account_password"},
+						Description:  "The Mixpanel project ID, found on the Project Settings page.",
+					},
+					"mixpanel_service_account_username": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						RequiredWith: []string{"sink.0.mixpanel_region", "sink.0.mixpanel_project_id", "sink.0.mixpanel_service_account_password"},
+						Description:  "The Mixpanel Service Account username. Services Accounts can be created in the Project Settings page.",
+					},
+					"mixpanel_service_account_password": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						RequiredWith: []string{"sink.0.mixpanel_region", "sink.0.mixpanel_project_id", "sink.0.mixpanel_service_account_username"},
+						Description:  "The Mixpanel Service Account password.",
+					},
+					"segment_write_key": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The [Segment Write Key](https://segment.com/docs/connections/find-writekey/).",
+					},
 				},
 			},
 		},
@@ -271,13 +398,18 @@ func createLogStream(ctx context.Context, d *schema.ResourceData, m interface{})
 	d.SetId(logStream.GetID())
 
 	// The Management API only allows updating a log stream's status.
-	// Therefore, if the status field was present in the configuration,
-	// we perform an additional operation to modify it.
-	status := d.Get("status").(string)
-	if status != "" && status != logStream.GetStatus() {
-		if err := api.LogStream.Update(logStream.GetID(), &management.LogStream{Status: &status}); err != nil {
+	// Therefore, if desired_status was present in the configuration, we
+	// perform an additional operation to request it, then poll until Auth0
+	// reports that status back (or the sink is rejected and it never does).
+	desiredStatus := d.Get("desired_status").(string)
+	if desiredStatus != "" && desiredStatus != logStream.GetStatus() {
+		if err := api.LogStream.Update(logStream.GetID(), &management.LogStream{Status: &desiredStatus}); err != nil {
 			return diag.FromErr(err)
 		}
+
+		if diagnostics := waitForLogStreamStatus(ctx, d, api, desiredStatus, d.Timeout(schema.TimeoutCreate)); diagnostics.HasError() {
+			return diagnostics
+		}
 	}
 
 	return readLogStream(ctx, d, m)
@@ -303,7 +435,24 @@ func readLogStream(ctx context.Context, d *schema.ResourceData, m interface{}) d
 		d.Set("sink", flattenLogStreamSink(d, logStream.Sink)),
 	)
 
-	return diag.FromErr(result.ErrorOrNil())
+	diagnostics := diag.FromErr(result.ErrorOrNil())
+
+	// Auth0 can suspend a log stream on its own (e.g. after repeated sink
+	// delivery failures). Surface that as a warning instead of fighting it,
+	// since "status" is no longer a value we try to force back to "active".
+	if logStream.GetStatus() == "suspended" {
+		diagnostics = append(diagnostics, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Log stream was suspended by Auth0",
+			Detail: fmt.Sprintf(
+				"Log stream %q is currently \"suspended\" by Auth0, independent of its desired_status. "+
+					"This usually indicates repeated sink delivery failures. The Management API does not "+
+					"report a suspension reason, so it can't be surfaced here.", d.Id(),
+			),
+		})
+	}
+
+	return diagnostics
 }
 
 func updateLogStream(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -314,9 +463,62 @@ func updateLogStream(ctx context.Context, d *schema.ResourceData, m interface{})
 		return diag.FromErr(err)
 	}
 
+	// The Management API only allows updating a log stream's status through
+	// a separate call, same as on create, so a desired_status change has to
+	// be requested explicitly instead of relying on the update above.
+	if desiredStatus := d.Get("desired_status").(string); desiredStatus != "" && d.HasChange("desired_status") {
+		if err := api.LogStream.Update(d.Id(), &management.LogStream{Status: &desiredStatus}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if diagnostics := waitForLogStreamStatus(ctx, d, api, desiredStatus, d.Timeout(schema.TimeoutUpdate)); diagnostics.HasError() {
+			return diagnostics
+		}
+	}
+
 	return readLogStream(ctx, d, m)
 }
 
+// waitForLogStreamStatus polls the log stream with exponential backoff until
+// its status matches desiredStatus, the API reports it was suspended instead,
+// or timeout elapses.
+func waitForLogStreamStatus(ctx context.Context, d *schema.ResourceData, api *management.Management, desiredStatus string, timeout time.Duration) diag.Diagnostics {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		logStream, err := api.LogStream.Read(d.Id())
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return logStreamStatusRetryError(d.Id(), logStream.GetStatus(), desiredStatus)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// logStreamStatusRetryError decides, given the log stream's current and
+// desired status, whether waitForLogStreamStatus should keep polling: nil
+// once the desired status is observed, a non-retryable error once Auth0
+// reports the stream as suspended (polling further won't help), and a
+// retryable error otherwise.
+func logStreamStatusRetryError(id, currentStatus, desiredStatus string) *resource.RetryError {
+	switch currentStatus {
+	case desiredStatus:
+		return nil
+	case "suspended":
+		return resource.NonRetryableError(fmt.Errorf(
+			"log stream %q was suspended by Auth0 instead of reaching the desired status %q",
+			id, desiredStatus,
+		))
+	default:
+		return resource.RetryableError(fmt.Errorf(
+			"log stream %q has status %q, expected %q", id, currentStatus, desiredStatus,
+		))
+	}
+}
+
 func deleteLogStream(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*management.Management)
 
@@ -0,0 +1,110 @@
+package logstream
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestValidateLogStreamHTTPHeader(t *testing.T) {
+	t.Parallel()
+
+	for name, testCase := range map[string]struct {
+		givenHeader string
+		expectError bool
+	}{
+		"allowed custom header": {
+			givenHeader: "X-Custom-Header",
+			expectError: false,
+		},
+		"forbidden lowercase header is rejected": {
+			givenHeader: "content-type",
+			expectError: true,
+		},
+		"forbidden header mixed case is rejected": {
+			givenHeader: "Content-Type",
+			expectError: true,
+		},
+		"authorization is reserved": {
+			givenHeader: "Authorization",
+			expectError: true,
+		},
+		"hop-by-hop header is reserved": {
+			givenHeader: "Transfer-Encoding",
+			expectError: true,
+		},
+	} {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := validateLogStreamHTTPHeader(testCase.givenHeader, "header")
+
+			if testCase.expectError && len(errs) == 0 {
+				t.Errorf("expected an error for header %q, got none", testCase.givenHeader)
+			}
+			if !testCase.expectError && len(errs) != 0 {
+				t.Errorf("expected no error for header %q, got %v", testCase.givenHeader, errs)
+			}
+		})
+	}
+}
+
+// TestLogStreamStatusRetryError covers the decision waitForLogStreamStatus
+// relies on to poll-until-active and to stop (rather than spin until the
+// timeout) once Auth0 reports the stream as suspended.
+func TestLogStreamStatusRetryError(t *testing.T) {
+	t.Parallel()
+
+	for name, testCase := range map[string]struct {
+		currentStatus   string
+		desiredStatus   string
+		expectRetryable bool
+		expectNil       bool
+	}{
+		"reached desired status stops polling": {
+			currentStatus: "active",
+			desiredStatus: "active",
+			expectNil:     true,
+		},
+		"not yet at desired status keeps polling": {
+			currentStatus:   "paused",
+			desiredStatus:   "active",
+			expectRetryable: true,
+		},
+		"suspended stops polling without retrying": {
+			currentStatus:   "suspended",
+			desiredStatus:   "active",
+			expectRetryable: false,
+		},
+	} {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := logStreamStatusRetryError("log-stream-id", testCase.currentStatus, testCase.desiredStatus)
+
+			if testCase.expectNil {
+				if err != nil {
+					t.Fatalf("expected nil error, got %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected a *resource.RetryError, got nil")
+			}
+
+			retryable := isRetryableError(err)
+			if retryable != testCase.expectRetryable {
+				t.Errorf("got retryable=%v, expected %v (err: %v)", retryable, testCase.expectRetryable, err)
+			}
+		})
+	}
+}
+
+func isRetryableError(err *resource.RetryError) bool {
+	return err.Retryable
+}